@@ -0,0 +1,79 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+import "sync"
+
+// AddInto computes dst = a + b without allocating. dst, a and b must
+// all have the same length; dst may alias a or b.
+func AddInto(dst, a, b Vector) {
+	a.AddTo(dst, b)
+}
+
+// SubInto computes dst = a - b without allocating. dst, a and b must
+// all have the same length; dst may alias a or b.
+func SubInto(dst, a, b Vector) {
+	a.SubTo(dst, b)
+}
+
+// HadamardInto computes dst = a * b element-wise without allocating.
+// dst, a and b must all have the same length; dst may alias a or b.
+func HadamardInto(dst, a, b Vector) {
+	a.HadamardTo(dst, b)
+}
+
+// ScaleInto computes dst = a * value without allocating. dst and a
+// must have the same length; dst may alias a.
+func ScaleInto(dst, a Vector, value float64) {
+	a.ScaleTo(dst, value)
+}
+
+// AxpyInto computes dst = alpha*x + y without allocating. dst, x and
+// y must all have the same length; dst may alias x or y.
+func AxpyInto(dst Vector, alpha float64, x, y Vector) {
+	y.AxpyTo(dst, alpha, x)
+}
+
+// vectorPool holds reusable Vectors in a single flat pool. Acquire
+// only reuses what it's given if its capacity already covers the
+// requested size, so callers that Release buffers of a consistent
+// size get the most benefit.
+var vectorPool = sync.Pool{
+	New: func() interface{} {
+		return Vector(nil)
+	},
+}
+
+// Acquire returns a Vector of the given size, reusing a pooled buffer
+// when one of sufficient capacity is available. The returned Vector is
+// always zeroed. Pair every Acquire with a Release once the Vector is
+// no longer needed.
+func Acquire(size int) Vector {
+	v, _ := vectorPool.Get().(Vector)
+	if cap(v) < size {
+		return make(Vector, size)
+	}
+	v = v[:size]
+	v.Zero()
+	return v
+}
+
+// Release returns v to the pool so a future Acquire can reuse its
+// backing array. Do not use v after calling Release.
+func Release(v Vector) {
+	vectorPool.Put(v)
+}
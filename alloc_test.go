@@ -0,0 +1,90 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddIntoAliasingDst(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{1.0, 2.0, 3.0})
+	b := NewWithValues([]float64{4.0, 5.0, 6.0})
+
+	AddInto(a, a, b)
+	assert.Equal(Vector{5.0, 7.0, 9.0}, a)
+
+	c := NewWithValues([]float64{1.0, 2.0, 3.0})
+	AddInto(b, c, b)
+	assert.Equal(Vector{5.0, 7.0, 9.0}, b)
+}
+
+func TestSubIntoAliasingDst(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{4.0, 5.0, 6.0})
+	b := NewWithValues([]float64{1.0, 2.0, 3.0})
+
+	SubInto(a, a, b)
+	assert.Equal(Vector{3.0, 3.0, 3.0}, a)
+}
+
+func TestHadamardIntoAliasingDst(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{2.0, 3.0, 4.0})
+	b := NewWithValues([]float64{5.0, 6.0, 7.0})
+
+	HadamardInto(a, a, b)
+	assert.Equal(Vector{10.0, 18.0, 28.0}, a)
+}
+
+func TestScaleIntoAliasingDst(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{1.0, 2.0, 3.0})
+
+	ScaleInto(a, a, 2.0)
+	assert.Equal(Vector{2.0, 4.0, 6.0}, a)
+}
+
+func TestAxpyIntoAliasingDst(t *testing.T) {
+	assert := assert.New(t)
+	x := NewWithValues([]float64{1.0, 1.0, 1.0})
+	y := NewWithValues([]float64{1.0, 2.0, 3.0})
+
+	AxpyInto(y, 2.0, x, y)
+	assert.Equal(Vector{3.0, 4.0, 5.0}, y)
+
+	AxpyInto(x, 2.0, x, y)
+	assert.Equal(Vector{5.0, 6.0, 7.0}, x)
+}
+
+func TestAcquireRelease(t *testing.T) {
+	assert := assert.New(t)
+	v := Acquire(4)
+	assert.Equal(4, len(v))
+	for _, e := range v {
+		assert.Equal(0.0, e)
+	}
+
+	v[0] = 9.0
+	Release(v)
+
+	v2 := Acquire(4)
+	assert.Equal(4, len(v2))
+	assert.Equal(0.0, v2[0])
+}
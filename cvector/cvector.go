@@ -0,0 +1,157 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cvector provides a complex128 counterpart to the vector
+// package, for DSP and quantum-state style workloads that need
+// complex-valued vectors and a Hermitian inner product.
+package cvector
+
+import (
+	"math"
+	"math/cmplx"
+
+	vector "github.com/olmiik/go-vector"
+)
+
+// CVector represents a complex-valued mathematical vector.
+type CVector []complex128
+
+// New returns a complex vector of the specified size.
+func New(size int) CVector {
+	return make(CVector, size)
+}
+
+// NewWithValues returns a complex vector with the specified values.
+// The size of the new vector is equal to that of the array.
+func NewWithValues(values []complex128) CVector {
+	v := make(CVector, len(values))
+	copy(v, values)
+	return v
+}
+
+// Clone this vector, returning a new CVector.
+func (v CVector) Clone() CVector {
+	return NewWithValues(v)
+}
+
+// Set sets the values of this vector.
+func (v CVector) Set(values []complex128) {
+	copy(v, values)
+}
+
+// Scale this vector (performs scalar multiplication) by the specified value.
+func (v CVector) Scale(value complex128) {
+	for i := range v {
+		v[i] *= value
+	}
+}
+
+// Magnitude returns the magnitude of this vector.
+func (v CVector) Magnitude() float64 {
+	result := 0.0
+	for _, e := range v {
+		m := cmplx.Abs(e)
+		result += m * m
+	}
+	return math.Sqrt(result)
+}
+
+// Conj conjugates this vector in place.
+func (v CVector) Conj() {
+	for i, e := range v {
+		v[i] = cmplx.Conj(e)
+	}
+}
+
+// Add adds another vector and returns the result as a new vector.
+// Another vector must have the same dimensionality.
+func (v CVector) Add(other CVector) (CVector, error) {
+	if len(v) != len(other) {
+		return nil, &DimensionError{Op: "Add", A: len(v), B: len(other)}
+	}
+
+	l := len(v)
+	result := make(CVector, l)
+	for i := 0; i < l; i++ {
+		result[i] = v[i] + other[i]
+	}
+	return result, nil
+}
+
+// Sub subtracts another vector and returns the result as a new vector.
+// Another vector must have the same dimensionality.
+func (v CVector) Sub(other CVector) (CVector, error) {
+	if len(v) != len(other) {
+		return nil, &DimensionError{Op: "Sub", A: len(v), B: len(other)}
+	}
+
+	l := len(v)
+	result := make(CVector, l)
+	for i := 0; i < l; i++ {
+		result[i] = v[i] - other[i]
+	}
+	return result, nil
+}
+
+// Hadamard computes the Hadamard product with another vector and
+// returns the result as a new vector. Another vector must have the
+// same dimensionality.
+func (v CVector) Hadamard(other CVector) (CVector, error) {
+	if len(v) != len(other) {
+		return nil, &DimensionError{Op: "Hadamard", A: len(v), B: len(other)}
+	}
+
+	l := len(v)
+	result := make(CVector, l)
+	for i := 0; i < l; i++ {
+		result[i] = v[i] * other[i]
+	}
+
+	return result, nil
+}
+
+// Dot computes the Hermitian inner product with another vector,
+// conjugating other. Another vector must have the same dimensionality.
+func (v CVector) Dot(other CVector) (complex128, error) {
+	if len(v) != len(other) {
+		return 0, &DimensionError{Op: "Dot", A: len(v), B: len(other)}
+	}
+
+	var result complex128
+	for i, e := range v {
+		result += e * cmplx.Conj(other[i])
+	}
+
+	return result, nil
+}
+
+// Real returns the real part of this vector as a vector.Vector.
+func (v CVector) Real() vector.Vector {
+	result := make(vector.Vector, len(v))
+	for i, e := range v {
+		result[i] = real(e)
+	}
+	return result
+}
+
+// Imag returns the imaginary part of this vector as a vector.Vector.
+func (v CVector) Imag() vector.Vector {
+	result := make(vector.Vector, len(v))
+	for i, e := range v {
+		result[i] = imag(e)
+	}
+	return result
+}
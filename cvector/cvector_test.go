@@ -0,0 +1,131 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cvector
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWithValues(t *testing.T) {
+	assert := assert.New(t)
+	v := NewWithValues([]complex128{complex(1, 2), complex(3, -4)})
+	assert.Equal(complex(1, 2), v[0])
+	assert.Equal(complex(3, -4), v[1])
+}
+
+func TestAdd(t *testing.T) {
+	assert := assert.New(t)
+	v1 := NewWithValues([]complex128{complex(1, 1), complex(2, 2)})
+	v2 := NewWithValues([]complex128{complex(1, -1), complex(2, -2)})
+	result, err := v1.Add(v2)
+	assert.Nil(err)
+	assert.Equal(complex(2, 0), result[0])
+	assert.Equal(complex(4, 0), result[1])
+
+	v3 := NewWithValues([]complex128{complex(1, 0)})
+	_, err = v1.Add(v3)
+	assert.NotNil(err)
+}
+
+func TestSub(t *testing.T) {
+	assert := assert.New(t)
+	v1 := NewWithValues([]complex128{complex(1, 1), complex(2, 2)})
+	v2 := NewWithValues([]complex128{complex(1, -1), complex(2, -2)})
+	result, err := v1.Sub(v2)
+	assert.Nil(err)
+	assert.Equal(complex(0, 2), result[0])
+	assert.Equal(complex(0, 4), result[1])
+
+	v3 := NewWithValues([]complex128{complex(1, 0)})
+	_, err = v1.Sub(v3)
+	assert.NotNil(err)
+}
+
+func TestHadamard(t *testing.T) {
+	assert := assert.New(t)
+	v1 := NewWithValues([]complex128{complex(2, 0), complex(0, 1)})
+	v2 := NewWithValues([]complex128{complex(3, 0), complex(0, 1)})
+	result, err := v1.Hadamard(v2)
+	assert.Nil(err)
+	assert.Equal(complex(6, 0), result[0])
+	assert.Equal(complex(-1, 0), result[1])
+
+	v3 := NewWithValues([]complex128{complex(1, 0)})
+	_, err = v1.Hadamard(v3)
+	assert.NotNil(err)
+}
+
+func TestDotIsHermitian(t *testing.T) {
+	assert := assert.New(t)
+	v1 := NewWithValues([]complex128{complex(0, 1)})
+	v2 := NewWithValues([]complex128{complex(0, 1)})
+	result, err := v1.Dot(v2)
+	assert.Nil(err)
+	// (0+1i) * conj(0+1i) = (0+1i)*(0-1i) = 1
+	assert.Equal(complex(1, 0), result)
+}
+
+func TestConj(t *testing.T) {
+	assert := assert.New(t)
+	v := NewWithValues([]complex128{complex(1, 2), complex(3, -4)})
+	v.Conj()
+	assert.Equal(complex(1, -2), v[0])
+	assert.Equal(complex(3, 4), v[1])
+}
+
+func TestMagnitude(t *testing.T) {
+	assert := assert.New(t)
+	v := NewWithValues([]complex128{complex(3, 0), complex(0, 4)})
+	assert.Equal(5.0, v.Magnitude())
+}
+
+func TestRealImag(t *testing.T) {
+	assert := assert.New(t)
+	v := NewWithValues([]complex128{complex(1, 2), complex(3, 4)})
+	re := v.Real()
+	im := v.Imag()
+	assert.Equal(1.0, re[0])
+	assert.Equal(3.0, re[1])
+	assert.Equal(2.0, im[0])
+	assert.Equal(4.0, im[1])
+}
+
+func TestAddReturnsDimensionError(t *testing.T) {
+	assert := assert.New(t)
+	v1 := NewWithValues([]complex128{complex(1, 0), complex(2, 0)})
+	v2 := NewWithValues([]complex128{complex(1, 0), complex(2, 0), complex(3, 0)})
+
+	_, err := v1.Add(v2)
+	assert.True(errors.Is(err, ErrVectorNotSameSize))
+
+	var dimErr *DimensionError
+	assert.True(errors.As(err, &dimErr))
+	assert.Equal("Add", dimErr.Op)
+	assert.Equal(2, dimErr.A)
+	assert.Equal(3, dimErr.B)
+}
+
+func TestScale(t *testing.T) {
+	assert := assert.New(t)
+	v := NewWithValues([]complex128{complex(1, 1), complex(2, 2)})
+	v.Scale(complex(2, 0))
+	assert.Equal(complex(2, 2), v[0])
+	assert.Equal(complex(4, 4), v[1])
+}
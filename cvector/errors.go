@@ -0,0 +1,45 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cvector
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrVectorNotSameSize is the sentinel wrapped by a DimensionError
+// returned when two vectors are expected to have equal
+// dimensionality but do not. Check for it with errors.Is.
+var ErrVectorNotSameSize = errors.New("cvector: vectors are not the same size")
+
+// DimensionError reports a dimensionality failure for Op, the
+// operation that failed, giving the two operands' lengths.
+type DimensionError struct {
+	Op   string
+	A, B int
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("cvector: %s: dimension mismatch (%d != %d)", e.Op, e.A, e.B)
+}
+
+// Is reports whether target is ErrVectorNotSameSize, so callers can
+// use errors.Is without caring whether they're looking at a plain
+// sentinel or a DimensionError wrapping one.
+func (e *DimensionError) Is(target error) bool {
+	return target == ErrVectorNotSameSize
+}
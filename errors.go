@@ -0,0 +1,88 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDimensionMismatch is the sentinel wrapped by a DimensionError
+// returned when two operands that are expected to have equal
+// dimensionality do not. Check for it with errors.Is.
+var ErrDimensionMismatch = errors.New("vector: dimension mismatch")
+
+// ErrInvalidDimension is the sentinel wrapped by a DimensionError
+// returned when an operand does not have the fixed dimensionality an
+// operation requires (e.g. Cross requires length 3). Check for it
+// with errors.Is.
+var ErrInvalidDimension = errors.New("vector: invalid dimension")
+
+// ErrZeroMagnitude is returned by Unit and Project when the vector
+// whose magnitude they divide by has zero magnitude. Angle and
+// CosineSimilarity do not check for it and return NaN instead.
+var ErrZeroMagnitude = errors.New("vector: zero magnitude")
+
+// DimensionError reports a dimensionality failure for Op, the
+// operation that failed, giving the two lengths involved. For
+// operations on two vectors (Add, Dot, ...) A and B are the two
+// operands' lengths and it wraps ErrDimensionMismatch; for operations
+// that require a fixed dimensionality (Cross requires 3) B is that
+// required length, Fixed is set to true, and it wraps
+// ErrInvalidDimension instead.
+type DimensionError struct {
+	Op    string
+	A, B  int
+	Fixed bool
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("vector: %s: dimension mismatch (%d != %d)", e.Op, e.A, e.B)
+}
+
+// Is reports whether target is the single sentinel error that this
+// DimensionError wraps, so callers can use errors.Is without caring
+// whether they're looking at a plain sentinel or a DimensionError
+// wrapping one.
+func (e *DimensionError) Is(target error) bool {
+	if e.Fixed {
+		return target == ErrInvalidDimension
+	}
+	return target == ErrDimensionMismatch
+}
+
+// MatrixDimensionError reports a shape mismatch for Op, the matrix
+// operation that failed, giving both matrices' Rows/Cols. Unlike
+// DimensionError, which reports two vector lengths, this keeps the
+// two dimensions separate so e.g. a 2x3 vs. a 3x2 matrix isn't
+// misreported as matching 6-element operands.
+type MatrixDimensionError struct {
+	Op           string
+	ARows, ACols int
+	BRows, BCols int
+}
+
+func (e *MatrixDimensionError) Error() string {
+	return fmt.Sprintf("vector: %s: dimension mismatch (%dx%d != %dx%d)", e.Op, e.ARows, e.ACols, e.BRows, e.BCols)
+}
+
+// Is reports whether target is ErrDimensionMismatch, so callers can
+// use errors.Is without caring whether they're looking at a plain
+// sentinel or a MatrixDimensionError wrapping one.
+func (e *MatrixDimensionError) Is(target error) bool {
+	return target == ErrDimensionMismatch
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddReturnsDimensionError(t *testing.T) {
+	assert := assert.New(t)
+	v1 := NewWithValues([]float64{1.0, 2.0})
+	v2 := NewWithValues([]float64{1.0, 2.0, 3.0})
+
+	_, err := v1.Add(v2)
+	assert.True(errors.Is(err, ErrDimensionMismatch))
+	assert.False(errors.Is(err, ErrInvalidDimension))
+
+	var dimErr *DimensionError
+	assert.True(errors.As(err, &dimErr))
+	assert.Equal("Add", dimErr.Op)
+	assert.Equal(2, dimErr.A)
+	assert.Equal(3, dimErr.B)
+}
+
+func TestCrossReturnsInvalidDimension(t *testing.T) {
+	assert := assert.New(t)
+	v1 := NewWithValues([]float64{1.0, 2.0})
+	v2 := NewWithValues([]float64{1.0, 2.0, 3.0})
+
+	_, err := v1.Cross(v2)
+	assert.True(errors.Is(err, ErrInvalidDimension))
+	assert.False(errors.Is(err, ErrDimensionMismatch))
+}
+
+func TestMustAddPanicsOnMismatch(t *testing.T) {
+	assert := assert.New(t)
+	v1 := NewWithValues([]float64{1.0, 2.0})
+	v2 := NewWithValues([]float64{1.0, 2.0, 3.0})
+
+	assert.Panics(func() { MustAdd(v1, v2) })
+}
+
+func TestMustAddMatchesAdd(t *testing.T) {
+	assert := assert.New(t)
+	v1 := NewWithValues([]float64{1.0, 2.0})
+	v2 := NewWithValues([]float64{3.0, 4.0})
+
+	result := MustAdd(v1, v2)
+	expected, _ := v1.Add(v2)
+	assert.Equal(expected, result)
+}
+
+func TestUnitZeroMagnitude(t *testing.T) {
+	assert := assert.New(t)
+	_, err := Unit(New(3))
+	assert.True(errors.Is(err, ErrZeroMagnitude))
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+import "math"
+
+// slerpEpsilon is the sin(omega) threshold below which Slerp falls
+// back to Lerp to avoid dividing by a near-zero value.
+const slerpEpsilon = 1e-6
+
+// Angle returns the angle in radians between a and b. Both vectors
+// must have the same dimensionality.
+func Angle(a, b Vector) (float64, error) {
+	dot, err := a.Dot(b)
+	if err != nil {
+		return 0.0, err
+	}
+
+	cos := dot / (a.Magnitude() * b.Magnitude())
+	if cos > 1.0 {
+		cos = 1.0
+	} else if cos < -1.0 {
+		cos = -1.0
+	}
+
+	return math.Acos(cos), nil
+}
+
+// CosineSimilarity returns the cosine of the angle between a and b,
+// in [-1, 1]. Both vectors must have the same dimensionality.
+func CosineSimilarity(a, b Vector) (float64, error) {
+	dot, err := a.Dot(b)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return dot / (a.Magnitude() * b.Magnitude()), nil
+}
+
+// Euclidean returns the Euclidean (L2) distance between a and b.
+// Both vectors must have the same dimensionality.
+func Euclidean(a, b Vector) (float64, error) {
+	if len(a) != len(b) {
+		return 0.0, &DimensionError{Op: "Euclidean", A: len(a), B: len(b)}
+	}
+
+	sum := 0.0
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return math.Sqrt(sum), nil
+}
+
+// Manhattan returns the Manhattan (L1) distance between a and b.
+// Both vectors must have the same dimensionality.
+func Manhattan(a, b Vector) (float64, error) {
+	if len(a) != len(b) {
+		return 0.0, &DimensionError{Op: "Manhattan", A: len(a), B: len(b)}
+	}
+
+	sum := 0.0
+	for i := range a {
+		sum += math.Abs(a[i] - b[i])
+	}
+	return sum, nil
+}
+
+// Chebyshev returns the Chebyshev (L-infinity) distance between a
+// and b. Both vectors must have the same dimensionality.
+func Chebyshev(a, b Vector) (float64, error) {
+	if len(a) != len(b) {
+		return 0.0, &DimensionError{Op: "Chebyshev", A: len(a), B: len(b)}
+	}
+
+	max := 0.0
+	for i := range a {
+		d := math.Abs(a[i] - b[i])
+		if d > max {
+			max = d
+		}
+	}
+	return max, nil
+}
+
+// Minkowski returns the Minkowski distance of order p between a and
+// b. p=1 is equivalent to Manhattan and p=2 to Euclidean. Both
+// vectors must have the same dimensionality.
+func Minkowski(a, b Vector, p float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0.0, &DimensionError{Op: "Minkowski", A: len(a), B: len(b)}
+	}
+
+	sum := 0.0
+	for i := range a {
+		sum += math.Pow(math.Abs(a[i]-b[i]), p)
+	}
+	return math.Pow(sum, 1.0/p), nil
+}
+
+// Project returns the orthogonal projection of a onto onto, as a new
+// vector. Both vectors must have the same dimensionality, and onto
+// must have non-zero magnitude.
+func Project(a, onto Vector) (Vector, error) {
+	dot, err := a.Dot(onto)
+	if err != nil {
+		return nil, err
+	}
+
+	mag := onto.Magnitude()
+	if mag == 0 {
+		return nil, ErrZeroMagnitude
+	}
+
+	result := onto.Clone()
+	result.Scale(dot / (mag * mag))
+	return result, nil
+}
+
+// Reject returns the vector rejection of a from onto, i.e. the
+// component of a orthogonal to onto, as a new vector. Both vectors
+// must have the same dimensionality, and onto must have non-zero
+// magnitude.
+func Reject(a, onto Vector) (Vector, error) {
+	proj, err := Project(a, onto)
+	if err != nil {
+		return nil, err
+	}
+	return MustSub(a, proj), nil
+}
+
+// Reflect reflects incident about normal and returns the result as a
+// new vector. normal is expected to be a unit vector, and both
+// vectors must have the same dimensionality.
+func Reflect(incident, normal Vector) (Vector, error) {
+	dot, err := incident.Dot(normal)
+	if err != nil {
+		return nil, err
+	}
+
+	result := normal.Clone()
+	result.Scale(2.0 * dot)
+	return MustSub(incident, result), nil
+}
+
+// Lerp linearly interpolates between a and b by t, returning the
+// result as a new vector. t is typically in [0, 1].
+func Lerp(a, b Vector, t float64) Vector {
+	result := MustSub(b, a)
+	result.Scale(t)
+	return MustAdd(a, result)
+}
+
+// Slerp spherically interpolates between a and b by t, returning the
+// result as a new vector. t is typically in [0, 1]. When a and b are
+// (anti-)parallel, sin(omega) is near zero, and when either input has
+// zero magnitude no angle can be formed; both cases fall back to Lerp.
+func Slerp(a, b Vector, t float64) Vector {
+	ua, errA := Unit(a)
+	ub, errB := Unit(b)
+	if errA != nil || errB != nil {
+		return Lerp(a, b, t)
+	}
+
+	cos := 0.0
+	if dot, err := ua.Dot(ub); err == nil {
+		cos = dot
+	}
+	if cos > 1.0 {
+		cos = 1.0
+	} else if cos < -1.0 {
+		cos = -1.0
+	}
+
+	omega := math.Acos(cos)
+	sinOmega := math.Sin(omega)
+	if sinOmega < slerpEpsilon && sinOmega > -slerpEpsilon {
+		return Lerp(a, b, t)
+	}
+
+	scaleA := math.Sin((1-t)*omega) / sinOmega
+	scaleB := math.Sin(t*omega) / sinOmega
+
+	termA := a.Clone()
+	termA.Scale(scaleA)
+	termB := b.Clone()
+	termB.Scale(scaleB)
+
+	return MustAdd(termA, termB)
+}
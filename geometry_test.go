@@ -0,0 +1,181 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAngleOrthogonal(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{1.0, 0.0})
+	b := NewWithValues([]float64{0.0, 1.0})
+	angle, err := Angle(a, b)
+	assert.Nil(err)
+	assert.InEpsilon(math.Pi/2, angle, EPSILON)
+}
+
+func TestAngleParallel(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{2.0, 0.0})
+	b := NewWithValues([]float64{5.0, 0.0})
+	angle, err := Angle(a, b)
+	assert.Nil(err)
+	assert.InDelta(0.0, angle, 1e-9)
+}
+
+func TestAngleAntiparallel(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{2.0, 0.0})
+	b := NewWithValues([]float64{-5.0, 0.0})
+	angle, err := Angle(a, b)
+	assert.Nil(err)
+	assert.InDelta(math.Pi, angle, 1e-9)
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{1.0, 0.0})
+	b := NewWithValues([]float64{0.0, 1.0})
+	sim, err := CosineSimilarity(a, b)
+	assert.Nil(err)
+	assert.InDelta(0.0, sim, 1e-9)
+}
+
+func TestEuclidean(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{0.0, 0.0})
+	b := NewWithValues([]float64{3.0, 4.0})
+	dist, err := Euclidean(a, b)
+	assert.Nil(err)
+	assert.Equal(5.0, dist)
+
+	_, err = Euclidean(a, NewWithValues([]float64{1.0}))
+	assert.True(errors.Is(err, ErrDimensionMismatch))
+}
+
+func TestManhattan(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{0.0, 0.0})
+	b := NewWithValues([]float64{3.0, 4.0})
+	dist, err := Manhattan(a, b)
+	assert.Nil(err)
+	assert.Equal(7.0, dist)
+}
+
+func TestChebyshev(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{0.0, 0.0})
+	b := NewWithValues([]float64{3.0, 4.0})
+	dist, err := Chebyshev(a, b)
+	assert.Nil(err)
+	assert.Equal(4.0, dist)
+}
+
+func TestMinkowski(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{0.0, 0.0})
+	b := NewWithValues([]float64{3.0, 4.0})
+
+	euclidean, err := Euclidean(a, b)
+	assert.Nil(err)
+	minkowski2, err := Minkowski(a, b, 2)
+	assert.Nil(err)
+	assert.InDelta(euclidean, minkowski2, 1e-9)
+
+	manhattan, err := Manhattan(a, b)
+	assert.Nil(err)
+	minkowski1, err := Minkowski(a, b, 1)
+	assert.Nil(err)
+	assert.InDelta(manhattan, minkowski1, 1e-9)
+}
+
+func TestProjectAndReject(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{2.0, 2.0})
+	onto := NewWithValues([]float64{1.0, 0.0})
+
+	proj, err := Project(a, onto)
+	assert.Nil(err)
+	assert.InDelta(2.0, proj[0], 1e-9)
+	assert.InDelta(0.0, proj[1], 1e-9)
+
+	rej, err := Reject(a, onto)
+	assert.Nil(err)
+	assert.InDelta(0.0, rej[0], 1e-9)
+	assert.InDelta(2.0, rej[1], 1e-9)
+
+	_, err = Project(a, New(2))
+	assert.True(errors.Is(err, ErrZeroMagnitude))
+}
+
+func TestReflect(t *testing.T) {
+	assert := assert.New(t)
+	incident := NewWithValues([]float64{1.0, -1.0})
+	normal := NewWithValues([]float64{0.0, 1.0})
+
+	result, err := Reflect(incident, normal)
+	assert.Nil(err)
+	assert.InDelta(1.0, result[0], 1e-9)
+	assert.InDelta(1.0, result[1], 1e-9)
+}
+
+func TestLerp(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{0.0, 0.0})
+	b := NewWithValues([]float64{10.0, 20.0})
+
+	result := Lerp(a, b, 0.25)
+	assert.InDelta(2.5, result[0], 1e-9)
+	assert.InDelta(5.0, result[1], 1e-9)
+}
+
+func TestSlerpOrthogonal(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{1.0, 0.0})
+	b := NewWithValues([]float64{0.0, 1.0})
+
+	result := Slerp(a, b, 0.5)
+	assert.InDelta(1.0, result.Magnitude(), 1e-9)
+	assert.InDelta(result[0], result[1], 1e-9)
+}
+
+func TestSlerpDegenerateFallsBackToLerp(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{2.0, 0.0})
+	b := NewWithValues([]float64{5.0, 0.0})
+
+	result := Slerp(a, b, 0.5)
+	lerp := Lerp(a, b, 0.5)
+	assert.InDelta(lerp[0], result[0], 1e-9)
+	assert.InDelta(lerp[1], result[1], 1e-9)
+}
+
+func TestSlerpAntiparallelFallsBackToLerp(t *testing.T) {
+	assert := assert.New(t)
+	a := NewWithValues([]float64{2.0, 0.0})
+	b := NewWithValues([]float64{-2.0, 0.0})
+
+	result := Slerp(a, b, 0.5)
+	lerp := Lerp(a, b, 0.5)
+	assert.InDelta(lerp[0], result[0], 1e-9)
+	assert.InDelta(lerp[1], result[1], 1e-9)
+}
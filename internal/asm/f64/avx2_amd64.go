@@ -0,0 +1,65 @@
+//go:build amd64
+
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f64
+
+import "golang.org/x/sys/cpu"
+
+// The declarations below are implemented in the *_amd64.s files in
+// this package as hand-written AVX2 assembly, processing 4 float64s
+// per YMM register per iteration with a scalar tail for the
+// remainder. They require len(x) == len(y) (and == len(dst), for the
+// three-operand kernels); callers of the exported *Unitary vars are
+// responsible for that invariant, same as for the Go fallbacks.
+
+//go:noescape
+func axpyUnitaryAVX2(alpha float64, x, y []float64)
+
+//go:noescape
+func scalUnitaryAVX2(alpha float64, x []float64)
+
+//go:noescape
+func dotUnitaryAVX2(x, y []float64) float64
+
+//go:noescape
+func addUnitaryAVX2(dst, x, y []float64)
+
+//go:noescape
+func subUnitaryAVX2(dst, x, y []float64)
+
+//go:noescape
+func hadamardUnitaryAVX2(dst, x, y []float64)
+
+func init() {
+	if cpu.X86.HasAVX2 {
+		AxpyUnitary = axpyUnitaryAVX2
+		ScalUnitary = scalUnitaryAVX2
+		DotUnitary = dotUnitaryAVX2
+		AddUnitary = addUnitaryAVX2
+		SubUnitary = subUnitaryAVX2
+		HadamardUnitary = hadamardUnitaryAVX2
+		return
+	}
+
+	AxpyUnitary = axpyUnitaryUnrolled
+	ScalUnitary = scalUnitaryUnrolled
+	DotUnitary = dotUnitaryUnrolled
+	AddUnitary = addUnitaryUnrolled
+	SubUnitary = subUnitaryUnrolled
+	HadamardUnitary = hadamardUnitaryUnrolled
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package f64 provides float64 vector kernels for the vector package's
+// hot paths, named after gonum's internal/asm/f64. Each exported
+// variable below is a function selected once at init time, so callers
+// never need to know which implementation backs it:
+//
+//   - on amd64 with AVX2 (checked via golang.org/x/sys/cpu), a
+//     hand-written .s kernel (see avx2_amd64.go for declarations,
+//     *_amd64.s for the assembly itself);
+//   - on amd64 without AVX2, a loop-unrolled portable Go fallback
+//     (see unrolled_amd64.go);
+//   - everywhere else, the plain scalar loop in generic.go.
+package f64
+
+// AxpyUnitary is the selected implementation of y[i] += alpha * x[i].
+var AxpyUnitary func(alpha float64, x, y []float64)
+
+// ScalUnitary is the selected implementation of x[i] *= alpha.
+var ScalUnitary func(alpha float64, x []float64)
+
+// DotUnitary is the selected implementation of the dot product of x and y.
+var DotUnitary func(x, y []float64) float64
+
+// AddUnitary is the selected implementation of dst[i] = x[i] + y[i].
+// dst may alias x or y.
+var AddUnitary func(dst, x, y []float64)
+
+// SubUnitary is the selected implementation of dst[i] = x[i] - y[i].
+// dst may alias x or y.
+var SubUnitary func(dst, x, y []float64)
+
+// HadamardUnitary is the selected implementation of
+// dst[i] = x[i] * y[i]. dst may alias x or y.
+var HadamardUnitary func(dst, x, y []float64)
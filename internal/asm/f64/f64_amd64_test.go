@@ -0,0 +1,103 @@
+//go:build amd64
+
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f64
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/cpu"
+)
+
+// These tests exercise the amd64-specific backends directly, rather
+// than through the platform-agnostic *Unitary vars in f64_test.go, so
+// a regression in one backend can't hide behind the other being
+// selected on the machine running the test.
+
+func TestUnrolledMatchesGeneric(t *testing.T) {
+	assert := assert.New(t)
+	for _, n := range []int{0, 1, 3, 4, 8, 17, 64} {
+		x := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		y1 := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+		y2 := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+		axpyUnitaryUnrolled(1.5, x, y1)
+		axpyUnitaryGeneric(1.5, x, y2)
+		assert.Equal(y2, y1)
+
+		s1 := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		s2 := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		scalUnitaryUnrolled(2.25, s1)
+		scalUnitaryGeneric(2.25, s2)
+		assert.Equal(s2, s1)
+
+		assert.InDelta(dotUnitaryGeneric(x, y2), dotUnitaryUnrolled(x, y2), 1e-9)
+
+		dst1 := make([]float64, n)
+		dst2 := make([]float64, n)
+		addUnitaryUnrolled(dst1, x, y2)
+		addUnitaryGeneric(dst2, x, y2)
+		assert.Equal(dst2, dst1)
+
+		subUnitaryUnrolled(dst1, x, y2)
+		subUnitaryGeneric(dst2, x, y2)
+		assert.Equal(dst2, dst1)
+
+		hadamardUnitaryUnrolled(dst1, x, y2)
+		hadamardUnitaryGeneric(dst2, x, y2)
+		assert.Equal(dst2, dst1)
+	}
+}
+
+func TestAVX2MatchesGeneric(t *testing.T) {
+	if !cpu.X86.HasAVX2 {
+		t.Skip("CPU does not support AVX2")
+	}
+
+	assert := assert.New(t)
+	for _, n := range []int{0, 1, 3, 4, 8, 17, 64} {
+		x := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		y1 := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+		y2 := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+		axpyUnitaryAVX2(1.5, x, y1)
+		axpyUnitaryGeneric(1.5, x, y2)
+		assert.Equal(y2, y1)
+
+		s1 := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		s2 := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		scalUnitaryAVX2(2.25, s1)
+		scalUnitaryGeneric(2.25, s2)
+		assert.Equal(s2, s1)
+
+		assert.InDelta(dotUnitaryGeneric(x, y2), dotUnitaryAVX2(x, y2), 1e-9)
+
+		dst1 := make([]float64, n)
+		dst2 := make([]float64, n)
+		addUnitaryAVX2(dst1, x, y2)
+		addUnitaryGeneric(dst2, x, y2)
+		assert.Equal(dst2, dst1)
+
+		subUnitaryAVX2(dst1, x, y2)
+		subUnitaryGeneric(dst2, x, y2)
+		assert.Equal(dst2, dst1)
+
+		hadamardUnitaryAVX2(dst1, x, y2)
+		hadamardUnitaryGeneric(dst2, x, y2)
+		assert.Equal(dst2, dst1)
+	}
+}
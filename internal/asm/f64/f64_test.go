@@ -0,0 +1,120 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f64
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// These tests check the kernel selected into each exported *Unitary
+// var at init time — AVX2 asm, the amd64 unrolled Go fallback, or the
+// portable generic loop, depending on platform and CPU features —
+// against the always-available generic implementation it must agree
+// with. They don't care which one was picked, so they pass
+// identically on every platform this package builds on.
+
+func makeSlice(n int, f func(i int) float64) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = f(i)
+	}
+	return s
+}
+
+func TestAxpyUnitaryMatchesGeneric(t *testing.T) {
+	assert := assert.New(t)
+	for _, n := range []int{0, 1, 3, 4, 8, 17, 64} {
+		x := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		y1 := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+		y2 := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+
+		AxpyUnitary(1.5, x, y1)
+		axpyUnitaryGeneric(1.5, x, y2)
+
+		assert.Equal(y2, y1)
+	}
+}
+
+func TestScalUnitaryMatchesGeneric(t *testing.T) {
+	assert := assert.New(t)
+	for _, n := range []int{0, 1, 3, 4, 8, 17, 64} {
+		x1 := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		x2 := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+
+		ScalUnitary(2.25, x1)
+		scalUnitaryGeneric(2.25, x2)
+
+		assert.Equal(x2, x1)
+	}
+}
+
+func TestDotUnitaryMatchesGeneric(t *testing.T) {
+	assert := assert.New(t)
+	for _, n := range []int{0, 1, 3, 4, 8, 17, 64} {
+		x := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		y := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+
+		assert.InDelta(dotUnitaryGeneric(x, y), DotUnitary(x, y), 1e-9)
+	}
+}
+
+func TestAddUnitaryMatchesGeneric(t *testing.T) {
+	assert := assert.New(t)
+	for _, n := range []int{0, 1, 3, 4, 8, 17, 64} {
+		x := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		y := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+		dst1 := make([]float64, n)
+		dst2 := make([]float64, n)
+
+		AddUnitary(dst1, x, y)
+		addUnitaryGeneric(dst2, x, y)
+
+		assert.Equal(dst2, dst1)
+	}
+}
+
+func TestSubUnitaryMatchesGeneric(t *testing.T) {
+	assert := assert.New(t)
+	for _, n := range []int{0, 1, 3, 4, 8, 17, 64} {
+		x := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		y := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+		dst1 := make([]float64, n)
+		dst2 := make([]float64, n)
+
+		SubUnitary(dst1, x, y)
+		subUnitaryGeneric(dst2, x, y)
+
+		assert.Equal(dst2, dst1)
+	}
+}
+
+func TestHadamardUnitaryMatchesGeneric(t *testing.T) {
+	assert := assert.New(t)
+	for _, n := range []int{0, 1, 3, 4, 8, 17, 64} {
+		x := makeSlice(n, func(i int) float64 { return float64(i) + 1 })
+		y := makeSlice(n, func(i int) float64 { return float64(i) * 2 })
+		dst1 := make([]float64, n)
+		dst2 := make([]float64, n)
+
+		HadamardUnitary(dst1, x, y)
+		hadamardUnitaryGeneric(dst2, x, y)
+
+		assert.Equal(dst2, dst1)
+	}
+}
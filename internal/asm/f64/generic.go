@@ -0,0 +1,63 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f64
+
+// axpyUnitaryGeneric is the portable fallback for AxpyUnitary. It is
+// used on platforms without an AVX2 kernel and in tests to check the
+// accelerated implementations against.
+func axpyUnitaryGeneric(alpha float64, x, y []float64) {
+	for i, v := range x {
+		y[i] += alpha * v
+	}
+}
+
+// scalUnitaryGeneric is the portable fallback for ScalUnitary.
+func scalUnitaryGeneric(alpha float64, x []float64) {
+	for i := range x {
+		x[i] *= alpha
+	}
+}
+
+// dotUnitaryGeneric is the portable fallback for DotUnitary.
+func dotUnitaryGeneric(x, y []float64) float64 {
+	var sum float64
+	for i, v := range x {
+		sum += v * y[i]
+	}
+	return sum
+}
+
+// addUnitaryGeneric is the portable fallback for AddUnitary.
+func addUnitaryGeneric(dst, x, y []float64) {
+	for i, v := range x {
+		dst[i] = v + y[i]
+	}
+}
+
+// subUnitaryGeneric is the portable fallback for SubUnitary.
+func subUnitaryGeneric(dst, x, y []float64) {
+	for i, v := range x {
+		dst[i] = v - y[i]
+	}
+}
+
+// hadamardUnitaryGeneric is the portable fallback for HadamardUnitary.
+func hadamardUnitaryGeneric(dst, x, y []float64) {
+	for i, v := range x {
+		dst[i] = v * y[i]
+	}
+}
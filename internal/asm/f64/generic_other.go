@@ -0,0 +1,30 @@
+//go:build !amd64
+
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f64
+
+// On non-amd64 platforms there is no accelerated variant, so the
+// portable scalar kernels are selected directly.
+func init() {
+	AxpyUnitary = axpyUnitaryGeneric
+	ScalUnitary = scalUnitaryGeneric
+	DotUnitary = dotUnitaryGeneric
+	AddUnitary = addUnitaryGeneric
+	SubUnitary = subUnitaryGeneric
+	HadamardUnitary = hadamardUnitaryGeneric
+}
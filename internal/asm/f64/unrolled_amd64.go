@@ -0,0 +1,113 @@
+//go:build amd64
+
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f64
+
+// The kernels below are the amd64 fallback used when the CPU lacks
+// AVX2 (see avx2_amd64.go for the feature check that selects between
+// these and the real AVX2 .s kernels). They are 4-way unrolled
+// portable Go, not SIMD assembly, but still give the compiler a
+// better shot at autovectorizing and overlapping independent
+// accumulator chains than the plain scalar loop in generic.go.
+
+func axpyUnitaryUnrolled(alpha float64, x, y []float64) {
+	n := len(x)
+	i := 0
+	for ; i <= n-4; i += 4 {
+		y[i] += alpha * x[i]
+		y[i+1] += alpha * x[i+1]
+		y[i+2] += alpha * x[i+2]
+		y[i+3] += alpha * x[i+3]
+	}
+	for ; i < n; i++ {
+		y[i] += alpha * x[i]
+	}
+}
+
+func scalUnitaryUnrolled(alpha float64, x []float64) {
+	n := len(x)
+	i := 0
+	for ; i <= n-4; i += 4 {
+		x[i] *= alpha
+		x[i+1] *= alpha
+		x[i+2] *= alpha
+		x[i+3] *= alpha
+	}
+	for ; i < n; i++ {
+		x[i] *= alpha
+	}
+}
+
+func dotUnitaryUnrolled(x, y []float64) float64 {
+	n := len(x)
+	var s0, s1, s2, s3 float64
+	i := 0
+	for ; i <= n-4; i += 4 {
+		s0 += x[i] * y[i]
+		s1 += x[i+1] * y[i+1]
+		s2 += x[i+2] * y[i+2]
+		s3 += x[i+3] * y[i+3]
+	}
+	sum := s0 + s1 + s2 + s3
+	for ; i < n; i++ {
+		sum += x[i] * y[i]
+	}
+	return sum
+}
+
+func addUnitaryUnrolled(dst, x, y []float64) {
+	n := len(x)
+	i := 0
+	for ; i <= n-4; i += 4 {
+		dst[i] = x[i] + y[i]
+		dst[i+1] = x[i+1] + y[i+1]
+		dst[i+2] = x[i+2] + y[i+2]
+		dst[i+3] = x[i+3] + y[i+3]
+	}
+	for ; i < n; i++ {
+		dst[i] = x[i] + y[i]
+	}
+}
+
+func subUnitaryUnrolled(dst, x, y []float64) {
+	n := len(x)
+	i := 0
+	for ; i <= n-4; i += 4 {
+		dst[i] = x[i] - y[i]
+		dst[i+1] = x[i+1] - y[i+1]
+		dst[i+2] = x[i+2] - y[i+2]
+		dst[i+3] = x[i+3] - y[i+3]
+	}
+	for ; i < n; i++ {
+		dst[i] = x[i] - y[i]
+	}
+}
+
+func hadamardUnitaryUnrolled(dst, x, y []float64) {
+	n := len(x)
+	i := 0
+	for ; i <= n-4; i += 4 {
+		dst[i] = x[i] * y[i]
+		dst[i+1] = x[i+1] * y[i+1]
+		dst[i+2] = x[i+2] * y[i+2]
+		dst[i+3] = x[i+3] * y[i+3]
+	}
+	for ; i < n; i++ {
+		dst[i] = x[i] * y[i]
+	}
+}
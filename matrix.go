@@ -0,0 +1,213 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+// Uplo identifies which triangle of a triangular matrix is populated.
+type Uplo int
+
+const (
+	// Upper identifies the upper triangle of a triangular matrix.
+	Upper Uplo = iota
+	// Lower identifies the lower triangle of a triangular matrix.
+	Lower
+)
+
+// Matrix represents a row-major dense matrix built on top of Vector.
+// Stride is the distance in Data between the start of one row and the
+// next; it is equal to Cols for a matrix that owns its storage, but
+// may be larger for a sub-matrix view so that rows remain zero-copy
+// slices of a larger backing array.
+type Matrix struct {
+	Data   []float64
+	Rows   int
+	Cols   int
+	Stride int
+}
+
+// NewMatrix returns a zeroed matrix with the given number of rows and
+// columns.
+func NewMatrix(rows, cols int) Matrix {
+	return Matrix{
+		Data:   make([]float64, rows*cols),
+		Rows:   rows,
+		Cols:   cols,
+		Stride: cols,
+	}
+}
+
+// NewMatrixFromRows returns a matrix whose rows are copies of the
+// given vectors. Every row must have the same length.
+func NewMatrixFromRows(rows []Vector) (Matrix, error) {
+	if len(rows) == 0 {
+		return NewMatrix(0, 0), nil
+	}
+
+	cols := len(rows[0])
+	for _, row := range rows {
+		if len(row) != cols {
+			return Matrix{}, &DimensionError{Op: "NewMatrixFromRows", A: len(row), B: cols}
+		}
+	}
+
+	m := NewMatrix(len(rows), cols)
+	for i, row := range rows {
+		copy(m.Row(i), row)
+	}
+	return m, nil
+}
+
+// View returns a Matrix view of the rows x cols sub-matrix of m
+// starting at (rowStart, colStart), sharing storage with m: writes
+// through the view are visible in m and vice versa. This is why Row
+// slices m.Cols elements out of every Stride elements of Data rather
+// than assuming the two are equal.
+func (m Matrix) View(rowStart, colStart, rows, cols int) Matrix {
+	start := rowStart*m.Stride + colStart
+	return Matrix{
+		Data:   m.Data[start:],
+		Rows:   rows,
+		Cols:   cols,
+		Stride: m.Stride,
+	}
+}
+
+// Row returns a zero-copy Vector view of row i.
+func (m Matrix) Row(i int) Vector {
+	start := i * m.Stride
+	return Vector(m.Data[start : start+m.Cols])
+}
+
+// Col returns the values of column j as a newly allocated Vector,
+// since a row-major matrix's columns are not contiguous in memory.
+func (m Matrix) Col(j int) Vector {
+	result := make(Vector, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		result[i] = m.At(i, j)
+	}
+	return result
+}
+
+// At returns the value at row i, column j.
+func (m Matrix) At(i, j int) float64 {
+	return m.Data[i*m.Stride+j]
+}
+
+// Set sets the value at row i, column j.
+func (m Matrix) Set(i, j int, value float64) {
+	m.Data[i*m.Stride+j] = value
+}
+
+// MulVec computes the matrix-vector product m*x (GEMV) and returns the
+// result as a new Vector. x's length must equal m.Cols.
+func (m Matrix) MulVec(x Vector) (Vector, error) {
+	if len(x) != m.Cols {
+		return nil, &DimensionError{Op: "MulVec", A: len(x), B: m.Cols}
+	}
+
+	result := make(Vector, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		v, _ := m.Row(i).Dot(x)
+		result[i] = v
+	}
+	return result, nil
+}
+
+// Mul computes the matrix product m*other (GEMM) and returns the
+// result as a new Matrix. m.Cols must equal other.Rows.
+func (m Matrix) Mul(other Matrix) (Matrix, error) {
+	if m.Cols != other.Rows {
+		return Matrix{}, &DimensionError{Op: "Mul", A: m.Cols, B: other.Rows}
+	}
+
+	result := NewMatrix(m.Rows, other.Cols)
+	for i := 0; i < m.Rows; i++ {
+		for k := 0; k < m.Cols; k++ {
+			mik := m.At(i, k)
+			if mik == 0 {
+				continue
+			}
+			row := result.Row(i)
+			row.AxpyTo(row, mik, other.Row(k))
+		}
+	}
+	return result, nil
+}
+
+// Transpose returns the transpose of m as a new Matrix.
+func (m Matrix) Transpose() Matrix {
+	result := NewMatrix(m.Cols, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			result.Set(j, i, m.At(i, j))
+		}
+	}
+	return result
+}
+
+// Add adds another matrix and returns the result as a new Matrix. Both
+// matrices must have the same dimensions.
+func (m Matrix) Add(other Matrix) (Matrix, error) {
+	if m.Rows != other.Rows || m.Cols != other.Cols {
+		return Matrix{}, &MatrixDimensionError{Op: "Add", ARows: m.Rows, ACols: m.Cols, BRows: other.Rows, BCols: other.Cols}
+	}
+
+	result := NewMatrix(m.Rows, m.Cols)
+	for i := 0; i < m.Rows; i++ {
+		m.Row(i).AddTo(result.Row(i), other.Row(i))
+	}
+	return result, nil
+}
+
+// Hadamard computes the element-wise product with another matrix and
+// returns the result as a new Matrix. Both matrices must have the
+// same dimensions.
+func (m Matrix) Hadamard(other Matrix) (Matrix, error) {
+	if m.Rows != other.Rows || m.Cols != other.Cols {
+		return Matrix{}, &MatrixDimensionError{Op: "Hadamard", ARows: m.Rows, ACols: m.Cols, BRows: other.Rows, BCols: other.Cols}
+	}
+
+	result := NewMatrix(m.Rows, m.Cols)
+	for i := 0; i < m.Rows; i++ {
+		HadamardInto(result.Row(i), m.Row(i), other.Row(i))
+	}
+	return result, nil
+}
+
+// FlattenTriangular expands a packed triangular slice of an n*n
+// matrix into a full Matrix, zeroing the unpopulated triangle. packed
+// holds the populated triangle's rows concatenated: for Upper, row i
+// contributes n-i values (the diagonal and everything to its right);
+// for Lower, row i contributes i+1 values (the diagonal and
+// everything to its left).
+func FlattenTriangular(n int, uplo Uplo, packed []float64) Matrix {
+	result := NewMatrix(n, n)
+	pos := 0
+	for i := 0; i < n; i++ {
+		if uplo == Upper {
+			for j := i; j < n; j++ {
+				result.Set(i, j, packed[pos])
+				pos++
+			}
+		} else {
+			for j := 0; j <= i; j++ {
+				result.Set(i, j, packed[pos])
+				pos++
+			}
+		}
+	}
+	return result
+}
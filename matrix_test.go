@@ -0,0 +1,162 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMatrixFromRows(t *testing.T) {
+	assert := assert.New(t)
+	m, err := NewMatrixFromRows([]Vector{
+		NewWithValues([]float64{1.0, 2.0}),
+		NewWithValues([]float64{3.0, 4.0}),
+	})
+	assert.Nil(err)
+	assert.Equal(2.0, m.At(0, 1))
+	assert.Equal(3.0, m.At(1, 0))
+
+	_, err = NewMatrixFromRows([]Vector{
+		NewWithValues([]float64{1.0, 2.0}),
+		NewWithValues([]float64{3.0}),
+	})
+	assert.NotNil(err)
+}
+
+func TestMatrixRowIsZeroCopy(t *testing.T) {
+	assert := assert.New(t)
+	m := NewMatrix(2, 2)
+	row := m.Row(0)
+	row[0] = 9.0
+	assert.Equal(9.0, m.At(0, 0))
+}
+
+func TestMatrixCol(t *testing.T) {
+	assert := assert.New(t)
+	m, _ := NewMatrixFromRows([]Vector{
+		NewWithValues([]float64{1.0, 2.0}),
+		NewWithValues([]float64{3.0, 4.0}),
+	})
+	col := m.Col(1)
+	assert.Equal(Vector{2.0, 4.0}, col)
+}
+
+func TestMatrixMulVec(t *testing.T) {
+	assert := assert.New(t)
+	m, _ := NewMatrixFromRows([]Vector{
+		NewWithValues([]float64{1.0, 0.0}),
+		NewWithValues([]float64{0.0, 1.0}),
+	})
+	x := NewWithValues([]float64{5.0, 6.0})
+
+	result, err := m.MulVec(x)
+	assert.Nil(err)
+	assert.Equal(Vector{5.0, 6.0}, result)
+}
+
+func TestMatrixMul(t *testing.T) {
+	assert := assert.New(t)
+	a, _ := NewMatrixFromRows([]Vector{
+		NewWithValues([]float64{1.0, 2.0}),
+		NewWithValues([]float64{3.0, 4.0}),
+	})
+	identity, _ := NewMatrixFromRows([]Vector{
+		NewWithValues([]float64{1.0, 0.0}),
+		NewWithValues([]float64{0.0, 1.0}),
+	})
+
+	result, err := a.Mul(identity)
+	assert.Nil(err)
+	assert.Equal(a.Data, result.Data)
+}
+
+func TestMatrixTranspose(t *testing.T) {
+	assert := assert.New(t)
+	a, _ := NewMatrixFromRows([]Vector{
+		NewWithValues([]float64{1.0, 2.0, 3.0}),
+	})
+
+	result := a.Transpose()
+	assert.Equal(3, result.Rows)
+	assert.Equal(1, result.Cols)
+	assert.Equal(2.0, result.At(1, 0))
+}
+
+func TestMatrixAddAndHadamard(t *testing.T) {
+	assert := assert.New(t)
+	a, _ := NewMatrixFromRows([]Vector{NewWithValues([]float64{1.0, 2.0})})
+	b, _ := NewMatrixFromRows([]Vector{NewWithValues([]float64{3.0, 4.0})})
+
+	sum, err := a.Add(b)
+	assert.Nil(err)
+	assert.Equal(Vector{4.0, 6.0}, sum.Row(0))
+
+	prod, err := a.Hadamard(b)
+	assert.Nil(err)
+	assert.Equal(Vector{3.0, 8.0}, prod.Row(0))
+}
+
+func TestMatrixAddReportsShape(t *testing.T) {
+	assert := assert.New(t)
+	a := NewMatrix(2, 3)
+	b := NewMatrix(3, 2)
+
+	_, err := a.Add(b)
+	assert.True(errors.Is(err, ErrDimensionMismatch))
+
+	var shapeErr *MatrixDimensionError
+	assert.True(errors.As(err, &shapeErr))
+	assert.Equal(2, shapeErr.ARows)
+	assert.Equal(3, shapeErr.ACols)
+	assert.Equal(3, shapeErr.BRows)
+	assert.Equal(2, shapeErr.BCols)
+}
+
+func TestMatrixView(t *testing.T) {
+	assert := assert.New(t)
+	m, _ := NewMatrixFromRows([]Vector{
+		NewWithValues([]float64{1.0, 2.0, 3.0}),
+		NewWithValues([]float64{4.0, 5.0, 6.0}),
+		NewWithValues([]float64{7.0, 8.0, 9.0}),
+	})
+
+	sub := m.View(1, 1, 2, 2)
+	assert.Equal(Vector{5.0, 6.0}, sub.Row(0))
+	assert.Equal(Vector{8.0, 9.0}, sub.Row(1))
+
+	sub.Set(0, 0, 50.0)
+	assert.Equal(50.0, m.At(1, 1))
+}
+
+func TestFlattenTriangularUpper(t *testing.T) {
+	assert := assert.New(t)
+	m := FlattenTriangular(3, Upper, []float64{1, 2, 3, 4, 5, 6})
+	assert.Equal(Vector{1.0, 2.0, 3.0}, m.Row(0))
+	assert.Equal(Vector{0.0, 4.0, 5.0}, m.Row(1))
+	assert.Equal(Vector{0.0, 0.0, 6.0}, m.Row(2))
+}
+
+func TestFlattenTriangularLower(t *testing.T) {
+	assert := assert.New(t)
+	m := FlattenTriangular(3, Lower, []float64{1, 2, 3, 4, 5, 6})
+	assert.Equal(Vector{1.0, 0.0, 0.0}, m.Row(0))
+	assert.Equal(Vector{2.0, 3.0, 0.0}, m.Row(1))
+	assert.Equal(Vector{4.0, 5.0, 6.0}, m.Row(2))
+}
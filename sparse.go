@@ -0,0 +1,175 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+// SparseVector represents a sparse mathematical vector: only the
+// stored entries are kept, as parallel Indices/Values slices kept
+// sorted in ascending index order. FromDense stores only non-zero
+// entries; Add and Sub keep an entry for every index present in
+// either operand, even where the result is zero. This is far cheaper than a dense
+// Vector for high-dimensional, mostly-zero data such as embeddings or
+// one-hot features.
+type SparseVector struct {
+	Indices []int
+	Values  []float64
+	Size    int
+}
+
+// NewSparse returns an empty sparse vector of the specified size.
+func NewSparse(size int) SparseVector {
+	return SparseVector{Size: size}
+}
+
+// FromDense builds a SparseVector from a dense Vector, keeping only
+// entries whose absolute value exceeds threshold.
+func FromDense(v Vector, threshold float64) SparseVector {
+	s := NewSparse(len(v))
+	for i, e := range v {
+		if e > threshold || e < -threshold {
+			s.Indices = append(s.Indices, i)
+			s.Values = append(s.Values, e)
+		}
+	}
+	return s
+}
+
+// ToDense expands this sparse vector into a dense Vector.
+func (s SparseVector) ToDense() Vector {
+	result := make(Vector, s.Size)
+	for i, idx := range s.Indices {
+		result[idx] = s.Values[i]
+	}
+	return result
+}
+
+// AxpyDense scatters alpha*s into dst, i.e. dst[i] += alpha * s[i] for
+// every non-zero entry of s. dst must have at least s.Size elements.
+func (s SparseVector) AxpyDense(alpha float64, dst Vector) {
+	for i, idx := range s.Indices {
+		dst[idx] += alpha * s.Values[i]
+	}
+}
+
+// Dot computes the dot product of this sparse vector with a dense
+// Vector, visiting only the non-zero entries of this vector. v must
+// have the same size as this vector.
+func (s SparseVector) Dot(v Vector) (float64, error) {
+	if s.Size != len(v) {
+		return 0.0, &DimensionError{Op: "Dot", A: s.Size, B: len(v)}
+	}
+
+	result := 0.0
+	for i, idx := range s.Indices {
+		result += s.Values[i] * v[idx]
+	}
+	return result, nil
+}
+
+// DotSparse computes the dot product with another sparse vector by
+// merge-walking both sorted index lists in O(nnz(s)+nnz(other)).
+// Both vectors must have the same size.
+func (s SparseVector) DotSparse(other SparseVector) (float64, error) {
+	if s.Size != other.Size {
+		return 0.0, &DimensionError{Op: "DotSparse", A: s.Size, B: other.Size}
+	}
+
+	result := 0.0
+	i, j := 0, 0
+	for i < len(s.Indices) && j < len(other.Indices) {
+		switch {
+		case s.Indices[i] == other.Indices[j]:
+			result += s.Values[i] * other.Values[j]
+			i++
+			j++
+		case s.Indices[i] < other.Indices[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return result, nil
+}
+
+// Add adds another sparse vector and returns the result as a new
+// sparse vector. Both vectors must have the same size.
+func (s SparseVector) Add(other SparseVector) (SparseVector, error) {
+	return s.merge("Add", other, func(a, b float64) float64 { return a + b })
+}
+
+// Sub subtracts another sparse vector and returns the result as a new
+// sparse vector. Both vectors must have the same size.
+func (s SparseVector) Sub(other SparseVector) (SparseVector, error) {
+	return s.merge("Sub", other, func(a, b float64) float64 { return a - b })
+}
+
+// Hadamard computes the Hadamard product with another sparse vector
+// and returns the result as a new sparse vector. Both vectors must
+// have the same size. Since the product of two zero entries is zero,
+// the result only has entries where both operands are non-zero.
+func (s SparseVector) Hadamard(other SparseVector) (SparseVector, error) {
+	if s.Size != other.Size {
+		return SparseVector{}, &DimensionError{Op: "Hadamard", A: s.Size, B: other.Size}
+	}
+
+	result := NewSparse(s.Size)
+	i, j := 0, 0
+	for i < len(s.Indices) && j < len(other.Indices) {
+		switch {
+		case s.Indices[i] == other.Indices[j]:
+			result.Indices = append(result.Indices, s.Indices[i])
+			result.Values = append(result.Values, s.Values[i]*other.Values[j])
+			i++
+			j++
+		case s.Indices[i] < other.Indices[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return result, nil
+}
+
+// merge walks both operands' sorted indices and combines overlapping
+// and disjoint entries with combine, used by Add and Sub where an
+// entry present in only one operand is combined with zero for the
+// other side (so Sub negates entries present only in other).
+func (s SparseVector) merge(op string, other SparseVector, combine func(a, b float64) float64) (SparseVector, error) {
+	if s.Size != other.Size {
+		return SparseVector{}, &DimensionError{Op: op, A: s.Size, B: other.Size}
+	}
+
+	result := NewSparse(s.Size)
+	i, j := 0, 0
+	for i < len(s.Indices) || j < len(other.Indices) {
+		switch {
+		case j >= len(other.Indices) || (i < len(s.Indices) && s.Indices[i] < other.Indices[j]):
+			result.Indices = append(result.Indices, s.Indices[i])
+			result.Values = append(result.Values, combine(s.Values[i], 0))
+			i++
+		case i >= len(s.Indices) || other.Indices[j] < s.Indices[i]:
+			result.Indices = append(result.Indices, other.Indices[j])
+			result.Values = append(result.Values, combine(0, other.Values[j]))
+			j++
+		default:
+			result.Indices = append(result.Indices, s.Indices[i])
+			result.Values = append(result.Values, combine(s.Values[i], other.Values[j]))
+			i++
+			j++
+		}
+	}
+	return result, nil
+}
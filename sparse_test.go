@@ -0,0 +1,108 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// denseWithRatio returns a dense vector of the given size where
+// roughly ratio of the entries are non-zero, spaced evenly so the
+// result exercises FromDense's threshold filtering deterministically.
+func denseWithRatio(size int, ratio float64) Vector {
+	v := make(Vector, size)
+	step := int(1.0 / ratio)
+	if step < 1 {
+		step = 1
+	}
+	for i := 0; i < size; i += step {
+		v[i] = float64(i + 1)
+	}
+	return v
+}
+
+func TestFromDenseAndToDense(t *testing.T) {
+	assert := assert.New(t)
+	for _, ratio := range []float64{0.01, 0.1, 0.5} {
+		dense := denseWithRatio(200, ratio)
+		sparse := FromDense(dense, 0.0)
+		assert.Equal(dense, sparse.ToDense())
+	}
+}
+
+func TestSparseDotDense(t *testing.T) {
+	assert := assert.New(t)
+	dense := NewWithValues([]float64{1.0, 0.0, 3.0, 0.0, 5.0})
+	sparse := FromDense(dense, 0.0)
+
+	other := NewWithValues([]float64{2.0, 2.0, 2.0, 2.0, 2.0})
+	result, err := sparse.Dot(other)
+	assert.Nil(err)
+	assert.Equal(18.0, result)
+
+	_, err = sparse.Dot(NewWithValues([]float64{1.0}))
+	assert.NotNil(err)
+}
+
+func TestSparseDotSparse(t *testing.T) {
+	assert := assert.New(t)
+	a := FromDense(NewWithValues([]float64{1.0, 0.0, 3.0, 0.0, 5.0}), 0.0)
+	b := FromDense(NewWithValues([]float64{0.0, 4.0, 3.0, 0.0, 2.0}), 0.0)
+
+	result, err := a.DotSparse(b)
+	assert.Nil(err)
+	assert.Equal(3.0*3.0+5.0*2.0, result)
+
+	c := NewSparse(3)
+	_, err = a.DotSparse(c)
+	assert.NotNil(err)
+}
+
+func TestSparseAddSub(t *testing.T) {
+	assert := assert.New(t)
+	a := FromDense(NewWithValues([]float64{1.0, 0.0, 3.0, 0.0, 5.0}), 0.0)
+	b := FromDense(NewWithValues([]float64{0.0, 4.0, 3.0, 0.0, 2.0}), 0.0)
+
+	sum, err := a.Add(b)
+	assert.Nil(err)
+	assert.Equal(Vector{1.0, 4.0, 6.0, 0.0, 7.0}, sum.ToDense())
+
+	diff, err := a.Sub(b)
+	assert.Nil(err)
+	assert.Equal(Vector{1.0, -4.0, 0.0, 0.0, 3.0}, diff.ToDense())
+}
+
+func TestSparseHadamard(t *testing.T) {
+	assert := assert.New(t)
+	a := FromDense(NewWithValues([]float64{1.0, 0.0, 3.0, 0.0, 5.0}), 0.0)
+	b := FromDense(NewWithValues([]float64{0.0, 4.0, 3.0, 0.0, 2.0}), 0.0)
+
+	result, err := a.Hadamard(b)
+	assert.Nil(err)
+	assert.Equal(Vector{0.0, 0.0, 9.0, 0.0, 10.0}, result.ToDense())
+}
+
+func TestSparseAxpyDense(t *testing.T) {
+	assert := assert.New(t)
+	a := FromDense(NewWithValues([]float64{1.0, 0.0, 3.0}), 0.0)
+	dst := NewWithValues([]float64{10.0, 10.0, 10.0})
+
+	a.AxpyDense(2.0, dst)
+	assert.Equal(Vector{12.0, 10.0, 16.0}, dst)
+}
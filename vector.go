@@ -18,17 +18,12 @@ package vector
 
 import (
 	"math"
+
+	"github.com/olmiik/go-vector/internal/asm/f64"
 )
 
 var EPSILON = math.Nextafter(1, 2) - 1
 
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
 // Vector represents mathematical vector.
 type Vector []float64
 
@@ -57,10 +52,15 @@ func (v Vector) Set(values []float64) {
 
 // Scale this vector (performs scalar multiplication) by the specified value.
 func (v Vector) Scale(value float64) {
-	l := len(v)
-	for i := 0; i < l; i++ {
-		v[i] *= value
-	}
+	f64.ScalUnitary(value, v)
+}
+
+// ScaleTo scales this vector by the specified value and writes the
+// result into dst, leaving this vector untouched. dst must have at
+// least the same length as this vector.
+func (v Vector) ScaleTo(dst Vector, value float64) {
+	copy(dst, v)
+	f64.ScalUnitary(value, dst[:len(v)])
 }
 
 // Magnitude returns the magnitude of this vector.
@@ -93,48 +93,109 @@ func (v Vector) DoWithIndex(applyFn func(int, float64) float64) {
 	}
 }
 
-// Add adds another vector and returns resutl as new vector.
-func (v Vector) Add(other Vector) Vector {
-	l := min(len(v), len(other))
-	result := make(Vector, l)
-	for i := 0; i < l; i++ {
-		result[i] = v[i] + other[i]
+// Add adds another vector and returns result as new vector. Another
+// vector must have the same dimensionality.
+func (v Vector) Add(other Vector) (Vector, error) {
+	if len(v) != len(other) {
+		return nil, &DimensionError{Op: "Add", A: len(v), B: len(other)}
 	}
-	return result
+
+	result := make(Vector, len(v))
+	v.AddTo(result, other)
+	return result, nil
 }
 
 // Sub substracts another vector and returns result as new vector.
-func (v Vector) Sub(other Vector) Vector {
-	l := min(len(v), len(other))
-	result := make(Vector, l)
-	for i := 0; i < l; i++ {
-		result[i] = v[i] - other[i]
+// Another vector must have the same dimensionality.
+func (v Vector) Sub(other Vector) (Vector, error) {
+	if len(v) != len(other) {
+		return nil, &DimensionError{Op: "Sub", A: len(v), B: len(other)}
+	}
+
+	result := make(Vector, len(v))
+	v.SubTo(result, other)
+	return result, nil
+}
+
+// MustAdd is like Add but panics instead of returning an error,
+// for call sites that already know the operands' lengths match.
+func MustAdd(v, other Vector) Vector {
+	result, err := v.Add(other)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// MustSub is like Sub but panics instead of returning an error,
+// for call sites that already know the operands' lengths match.
+func MustSub(v, other Vector) Vector {
+	result, err := v.Sub(other)
+	if err != nil {
+		panic(err)
 	}
 	return result
 }
 
+// AddTo adds other to this vector and writes the result into dst,
+// allocating nothing. dst may alias this vector or other. All three
+// vectors must have the same length.
+func (v Vector) AddTo(dst, other Vector) {
+	f64.AddUnitary(dst, v, other)
+}
+
+// SubTo subtracts other from this vector and writes the result into
+// dst, allocating nothing. dst may alias this vector or other. All
+// three vectors must have the same length.
+func (v Vector) SubTo(dst, other Vector) {
+	f64.SubUnitary(dst, v, other)
+}
+
+// HadamardTo computes the Hadamard product with other and writes the
+// result into dst, allocating nothing. dst may alias this vector or
+// other. All three vectors must have the same length.
+func (v Vector) HadamardTo(dst, other Vector) {
+	f64.HadamardUnitary(dst, v, other)
+}
+
+// AxpyTo computes alpha*x + this vector and writes the result into
+// dst, allocating nothing. dst may alias this vector or x. This
+// vector and x must have the same length.
+func (v Vector) AxpyTo(dst Vector, alpha float64, x Vector) {
+	if len(x) > 0 && len(dst) > 0 && &dst[0] == &x[0] {
+		// f64.AxpyUnitary computes y[i] += alpha*x[i] in place, so it
+		// can't be used directly when dst aliases x: copying v into
+		// dst first would overwrite x before it's read. Fall back to
+		// the elementwise loop, which reads x[i] before writing it.
+		for i, e := range v {
+			dst[i] = e + alpha*x[i]
+		}
+		return
+	}
+
+	copy(dst, v)
+	f64.AxpyUnitary(alpha, x, dst[:len(v)])
+}
+
 // Dot computes dot product with another vector.
 // Another vector must have the same dimensionality.
 func (v Vector) Dot(other Vector) (float64, error) {
 	if len(v) != len(other) {
-		return 0.0, ErrVectorNotSameSize
+		return 0.0, &DimensionError{Op: "Dot", A: len(v), B: len(other)}
 	}
 
-	l := len(v)
-	result := 0.0
-	for i := 0; i < l; i++ {
-		result += v[i] * other[i]
-	}
-
-	return result, nil
+	return f64.DotUnitary(v, other), nil
 }
 
 // Cross computes cross-product with another vector.
 // Vector dimensionality msut be equal to 3
 func (v Vector) Cross(other Vector) (Vector, error) {
 	// Early error check to prevent redundant cloning
-	if len(v) != 3 || len(other) != 3 {
-		return nil, ErrVectorInvalidDimension
+	if len(v) != 3 {
+		return nil, &DimensionError{Op: "Cross", A: len(v), B: 3, Fixed: true}
+	}
+	if len(other) != 3 {
+		return nil, &DimensionError{Op: "Cross", A: len(other), B: 3, Fixed: true}
 	}
 
 	result := make(Vector, 3)
@@ -145,15 +206,22 @@ func (v Vector) Cross(other Vector) (Vector, error) {
 	return result, nil
 }
 
-// Unit computes unit vector result as new vector.
-func Unit(v Vector) Vector {
-	magRec := 1.0 / v.Magnitude()
+// Unit computes unit vector result as new vector. It returns
+// ErrZeroMagnitude if v has zero magnitude, since the result would
+// otherwise be NaN or Inf.
+func Unit(v Vector) (Vector, error) {
+	mag := v.Magnitude()
+	if mag == 0 {
+		return nil, ErrZeroMagnitude
+	}
+
+	magRec := 1.0 / mag
 	unit := v.Clone()
 	for i := range unit {
 		unit[i] *= magRec
 	}
 
-	return unit
+	return unit, nil
 }
 
 // Hadamard computes Hadamard product with another vector
@@ -161,14 +229,11 @@ func Unit(v Vector) Vector {
 // have the same dimensionality.
 func (v Vector) Hadamard(other Vector) (Vector, error) {
 	if len(v) != len(other) {
-		return nil, ErrVectorInvalidDimension
+		return nil, &DimensionError{Op: "Hadamard", A: len(v), B: len(other)}
 	}
 
-	l := len(v)
-	result := make(Vector, l)
-	for i := 0; i < l; i++ {
-		result[i] = v[i] * other[i]
-	}
+	result := make(Vector, len(v))
+	v.HadamardTo(result, other)
 
 	return result, nil
 }
@@ -0,0 +1,142 @@
+/*
+Copyright 2017 Albert Tedja
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vector
+
+import (
+	"strconv"
+	"testing"
+)
+
+var benchSizes = []int{8, 64, 1024, 1 << 16}
+
+func benchVectors(n int) (Vector, Vector) {
+	a := make(Vector, n)
+	b := make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i] = float64(i)
+		b[i] = float64(n - i)
+	}
+	return a, b
+}
+
+func BenchmarkAdd(b *testing.B) {
+	for _, n := range benchSizes {
+		x, y := benchVectors(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Add(y)
+			}
+		})
+	}
+}
+
+func BenchmarkAddTo(b *testing.B) {
+	for _, n := range benchSizes {
+		x, y := benchVectors(n)
+		dst := make(Vector, n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				x.AddTo(dst, y)
+			}
+		})
+	}
+}
+
+func BenchmarkSub(b *testing.B) {
+	for _, n := range benchSizes {
+		x, y := benchVectors(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Sub(y)
+			}
+		})
+	}
+}
+
+func BenchmarkSubTo(b *testing.B) {
+	for _, n := range benchSizes {
+		x, y := benchVectors(n)
+		dst := make(Vector, n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				x.SubTo(dst, y)
+			}
+		})
+	}
+}
+
+func BenchmarkScale(b *testing.B) {
+	for _, n := range benchSizes {
+		x, _ := benchVectors(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x.Scale(1.0000001)
+			}
+		})
+	}
+}
+
+func BenchmarkDot(b *testing.B) {
+	for _, n := range benchSizes {
+		x, y := benchVectors(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Dot(y)
+			}
+		})
+	}
+}
+
+func BenchmarkHadamard(b *testing.B) {
+	for _, n := range benchSizes {
+		x, y := benchVectors(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Hadamard(y)
+			}
+		})
+	}
+}
+
+func BenchmarkHadamardTo(b *testing.B) {
+	for _, n := range benchSizes {
+		x, y := benchVectors(n)
+		dst := make(Vector, n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				x.HadamardTo(dst, y)
+			}
+		})
+	}
+}
+
+func BenchmarkAxpyTo(b *testing.B) {
+	for _, n := range benchSizes {
+		x, y := benchVectors(n)
+		dst := make(Vector, n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				x.AxpyTo(dst, 1.5, y)
+			}
+		})
+	}
+}
+
@@ -101,10 +101,14 @@ func TestCross(t *testing.T) {
 func TestUnit(t *testing.T) {
 	assert := assert.New(t)
 	v := NewWithValues([]float64{3.0, 4.0})
-	unit := Unit(v)
+	unit, err := Unit(v)
+	assert.Nil(err)
 	assert.InEpsilon(0.6, unit[0], EPSILON)
 	assert.InEpsilon(0.8, unit[1], EPSILON)
 	assert.Equal(1.0, unit.Magnitude())
+
+	_, err = Unit(New(3))
+	assert.Equal(ErrZeroMagnitude, err)
 }
 
 func TestHadamard(t *testing.T) {